@@ -0,0 +1,88 @@
+// Tencent is pleased to support the open source community by making trpc-mcp-go available.
+//
+// Copyright (C) 2025 THL A29 Limited, a Tencent company.  All rights reserved.
+//
+// trpc-mcp-go is licensed under the Apache License Version 2.0.
+
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// FileContentFetcher fetches content referenced by a "file://" URI from the local filesystem.
+type FileContentFetcher struct{}
+
+// Fetch implements ContentFetcher for "file://" URIs.
+func (FileContentFetcher) Fetch(ctx context.Context, uri string) ([]byte, string, error) {
+	path, err := filePathFromURI(uri)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, "", nil
+}
+
+// filePathFromURI strips the "file://" scheme prefix from uri, returning the local path.
+func filePathFromURI(uri string) (string, error) {
+	const prefix = "file://"
+	if len(uri) <= len(prefix) || uri[:len(prefix)] != prefix {
+		return "", fmt.Errorf("mcp: not a file:// URI: %q", uri)
+	}
+	return uri[len(prefix):], nil
+}
+
+// HTTPContentFetcher fetches content referenced by an "http://" or "https://" URI.
+type HTTPContentFetcher struct {
+	// Client is the HTTP client used to perform requests. If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+// Fetch implements ContentFetcher for "http://" and "https://" URIs.
+func (f HTTPContentFetcher) Fetch(ctx context.Context, uri string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("mcp: fetching %q: unexpected status %s", uri, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, resp.Header.Get("Content-Type"), nil
+}
+
+// ResourceContentFetcher fetches content referenced by a "resource://" URI, i.e. one pointing
+// back at an MCP resource exposed by a connected server. ReadResource is supplied by the
+// caller (typically a client session's ReadResource method) so this fetcher has no transport
+// dependency of its own.
+type ResourceContentFetcher struct {
+	// ReadResource reads the raw contents and mime type of the MCP resource at uri.
+	ReadResource func(ctx context.Context, uri string) (data []byte, mimeType string, err error)
+}
+
+// Fetch implements ContentFetcher for "resource://" URIs by delegating to ReadResource.
+func (f ResourceContentFetcher) Fetch(ctx context.Context, uri string) ([]byte, string, error) {
+	if f.ReadResource == nil {
+		return nil, "", fmt.Errorf("mcp: ResourceContentFetcher has no ReadResource configured")
+	}
+	return f.ReadResource(ctx, uri)
+}
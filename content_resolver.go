@@ -0,0 +1,128 @@
+// Tencent is pleased to support the open source community by making trpc-mcp-go available.
+//
+// Copyright (C) 2025 THL A29 Limited, a Tencent company.  All rights reserved.
+//
+// trpc-mcp-go is licensed under the Apache License Version 2.0.
+
+package mcp
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+)
+
+// ContentDeliveryCapability describes whether a session prefers URI-referenced content
+// (ImageURIContent / AudioURIContent) over inlined base64 content for large media. Servers
+// advertise this per-session; clients that don't understand URI content can leave it unset
+// to keep receiving inlined ImageContent/AudioContent as before.
+type ContentDeliveryCapability struct {
+	// SupportsURIContent indicates the session accepts image_uri/audio_uri content variants.
+	SupportsURIContent bool `json:"supportsUriContent,omitempty"`
+}
+
+// NewImageForSession builds the image Content a server should return for a session: when
+// capability indicates the session accepts URI content and uri is available, it references the
+// media by URI (ImageURIContent) to avoid the base64 bloat; otherwise it falls back to inlining
+// data as ImageContent so sessions that never advertised the capability keep working unchanged.
+func NewImageForSession(capability ContentDeliveryCapability, data string, mimeType string, uri string) Content {
+	if capability.SupportsURIContent && uri != "" {
+		return NewImageFromURI(uri, mimeType)
+	}
+	return NewImageContent(data, mimeType)
+}
+
+// NewAudioForSession builds the audio Content a server should return for a session: when
+// capability indicates the session accepts URI content and uri is available, it references the
+// media by URI (AudioURIContent) to avoid the base64 bloat; otherwise it falls back to inlining
+// data as AudioContent so sessions that never advertised the capability keep working unchanged.
+func NewAudioForSession(capability ContentDeliveryCapability, data string, mimeType string, uri string) Content {
+	if capability.SupportsURIContent && uri != "" {
+		return NewAudioFromURI(uri, mimeType)
+	}
+	return NewAudioContent(data, mimeType)
+}
+
+// ContentFetcher resolves the bytes backing a URI-referenced content value. Implementations
+// are registered against a URI scheme (e.g. "file", "http", "https", "resource") on a
+// ContentResolver.
+type ContentFetcher interface {
+	// Fetch retrieves the raw bytes and mime type for the given URI.
+	Fetch(ctx context.Context, uri string) (data []byte, mimeType string, err error)
+}
+
+// ContentResolver resolves URI-referenced content into its inlined equivalent on demand,
+// dispatching to a ContentFetcher registered for the URI's scheme.
+type ContentResolver struct {
+	fetchers map[string]ContentFetcher
+}
+
+// NewContentResolver creates an empty ContentResolver. Fetchers must be registered with
+// RegisterFetcher before Resolve is able to handle the corresponding URI scheme.
+func NewContentResolver() *ContentResolver {
+	return &ContentResolver{fetchers: make(map[string]ContentFetcher)}
+}
+
+// RegisterFetcher registers a ContentFetcher for the given URI scheme (e.g. "file", "http",
+// "https", "resource"). Registering a fetcher for a scheme that already has one replaces it.
+func (r *ContentResolver) RegisterFetcher(scheme string, fetcher ContentFetcher) {
+	r.fetchers[scheme] = fetcher
+}
+
+// Resolve converts a URI-referenced Content value into its inlined equivalent by fetching the
+// bytes through the fetcher registered for the URI's scheme. Only ImageURIContent and
+// AudioURIContent are resolved; every other Content value, including a VideoContent that
+// references its data by URI, is returned unchanged.
+func (r *ContentResolver) Resolve(ctx context.Context, content Content) (Content, error) {
+	switch c := content.(type) {
+	case ImageURIContent:
+		data, mimeType, err := r.fetch(ctx, c.URI)
+		if err != nil {
+			return nil, err
+		}
+		if mimeType == "" {
+			mimeType = c.MimeType
+		}
+		inlined := NewImageContent(data, mimeType)
+		inlined.Annotated = c.Annotated
+		return inlined, nil
+	case AudioURIContent:
+		data, mimeType, err := r.fetch(ctx, c.URI)
+		if err != nil {
+			return nil, err
+		}
+		if mimeType == "" {
+			mimeType = c.MimeType
+		}
+		inlined := NewAudioContent(data, mimeType)
+		inlined.Annotated = c.Annotated
+		return inlined, nil
+	default:
+		return content, nil
+	}
+}
+
+// fetch resolves uri to base64-encoded data and a mime type via the fetcher registered for its scheme.
+func (r *ContentResolver) fetch(ctx context.Context, uri string) (string, string, error) {
+	scheme := uriScheme(uri)
+	fetcher, ok := r.fetchers[scheme]
+	if !ok {
+		return "", "", fmt.Errorf("mcp: no content fetcher registered for scheme %q", scheme)
+	}
+	raw, mimeType, err := fetcher.Fetch(ctx, uri)
+	if err != nil {
+		return "", "", fmt.Errorf("mcp: fetching content %q: %w", uri, err)
+	}
+	return base64.StdEncoding.EncodeToString(raw), mimeType, nil
+}
+
+// uriScheme extracts the scheme portion of uri (the substring before "://"), or "" if uri has
+// no scheme separator.
+func uriScheme(uri string) string {
+	for i := 0; i+2 < len(uri); i++ {
+		if uri[i] == ':' && uri[i+1] == '/' && uri[i+2] == '/' {
+			return uri[:i]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+// Tencent is pleased to support the open source community by making trpc-mcp-go available.
+//
+// Copyright (C) 2025 THL A29 Limited, a Tencent company.  All rights reserved.
+//
+// trpc-mcp-go is licensed under the Apache License Version 2.0.
+
+package mcp
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+)
+
+type stubFetcher struct {
+	data     []byte
+	mimeType string
+	err      error
+}
+
+func (f stubFetcher) Fetch(ctx context.Context, uri string) ([]byte, string, error) {
+	return f.data, f.mimeType, f.err
+}
+
+func TestContentResolverResolveImage(t *testing.T) {
+	resolver := NewContentResolver()
+	resolver.RegisterFetcher("https", stubFetcher{data: []byte("abc"), mimeType: "image/png"})
+
+	resolved, err := resolver.Resolve(context.Background(), NewImageFromURI("https://example.com/a.png", "image/png"))
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	img, ok := resolved.(ImageContent)
+	if !ok {
+		t.Fatalf("Resolve() returned %T, want ImageContent", resolved)
+	}
+	if want := base64.StdEncoding.EncodeToString([]byte("abc")); img.Data != want {
+		t.Errorf("Data = %q, want %q", img.Data, want)
+	}
+	if img.MimeType != "image/png" {
+		t.Errorf("MimeType = %q, want %q", img.MimeType, "image/png")
+	}
+}
+
+func TestContentResolverResolveUnregisteredScheme(t *testing.T) {
+	resolver := NewContentResolver()
+
+	_, err := resolver.Resolve(context.Background(), NewAudioFromURI("ftp://example.com/a.wav", "audio/wav"))
+	if err == nil {
+		t.Fatal("expected error for unregistered scheme, got nil")
+	}
+}
+
+func TestContentResolverResolveLeavesInlinedContentUnchanged(t *testing.T) {
+	resolver := NewContentResolver()
+
+	original := NewTextContent("hello")
+	resolved, err := resolver.Resolve(context.Background(), original)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if resolved != Content(original) {
+		t.Errorf("Resolve() = %v, want unchanged %v", resolved, original)
+	}
+}
+
+func TestNewImageForSession(t *testing.T) {
+	data := base64.StdEncoding.EncodeToString([]byte("abc"))
+
+	t.Run("prefers URI when capability supports it", func(t *testing.T) {
+		c := NewImageForSession(ContentDeliveryCapability{SupportsURIContent: true}, data, "image/png", "https://example.com/a.png")
+		if _, ok := c.(ImageURIContent); !ok {
+			t.Errorf("got %T, want ImageURIContent", c)
+		}
+	})
+
+	t.Run("falls back to inlined content without the capability", func(t *testing.T) {
+		c := NewImageForSession(ContentDeliveryCapability{}, data, "image/png", "https://example.com/a.png")
+		if _, ok := c.(ImageContent); !ok {
+			t.Errorf("got %T, want ImageContent", c)
+		}
+	})
+}
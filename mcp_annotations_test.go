@@ -0,0 +1,122 @@
+// Tencent is pleased to support the open source community by making trpc-mcp-go available.
+//
+// Copyright (C) 2025 THL A29 Limited, a Tencent company.  All rights reserved.
+//
+// trpc-mcp-go is licensed under the Apache License Version 2.0.
+
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAnnotationsRoundTripWithExtra(t *testing.T) {
+	data := []byte(`{
+		"audience": ["user"],
+		"priority": 0.8,
+		"ttl": 60,
+		"confidence": 0.9,
+		"tags": ["summary"],
+		"language": "en-US",
+		"futureField": "keepme"
+	}`)
+
+	var a Annotations
+	if err := json.Unmarshal(data, &a); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if len(a.Audience) != 1 || a.Audience[0] != RoleUser {
+		t.Errorf("Audience = %v, want [%v]", a.Audience, RoleUser)
+	}
+	if a.Priority != 0.8 {
+		t.Errorf("Priority = %v, want 0.8", a.Priority)
+	}
+	if a.TTL != 60 {
+		t.Errorf("TTL = %v, want 60", a.TTL)
+	}
+	if a.Extra["futureField"] != "keepme" {
+		t.Errorf("Extra[futureField] = %v, want keepme", a.Extra["futureField"])
+	}
+
+	out, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var roundTripped map[string]interface{}
+	if err := json.Unmarshal(out, &roundTripped); err != nil {
+		t.Fatalf("Unmarshal() of marshaled output error = %v", err)
+	}
+	if roundTripped["futureField"] != "keepme" {
+		t.Errorf("marshaled output missing futureField, got %v", roundTripped)
+	}
+	if roundTripped["ttl"] != float64(60) {
+		t.Errorf("marshaled output ttl = %v, want 60", roundTripped["ttl"])
+	}
+}
+
+func withPriority(c TextContent, priority float64) TextContent {
+	c.Annotations = &Annotations{Priority: priority}
+	return c
+}
+
+func withTagAndAudience(c TextContent, tag string, audience Role) TextContent {
+	c.Annotations = &Annotations{Tags: []string{tag}, Audience: []Role{audience}}
+	return c
+}
+
+func TestContentFilter(t *testing.T) {
+	low := withPriority(NewTextContent("low"), 0.2)
+	high := withPriority(NewTextContent("high"), 0.8)
+	untagged := NewTextContent("untagged")
+	tagged := withTagAndAudience(NewTextContent("tagged"), "summary", RoleUser)
+
+	contents := []Content{low, high, untagged, tagged}
+
+	t.Run("MinPriority excludes untagged and low-priority content", func(t *testing.T) {
+		got := NewContentFilter().MinPriority(0.5).Apply(contents)
+		if len(got) != 1 || got[0].(TextContent).Text != "high" {
+			t.Errorf("Apply() = %v, want only %q", got, "high")
+		}
+	})
+
+	t.Run("MinPriority(0) still requires an annotated priority", func(t *testing.T) {
+		got := NewContentFilter().MinPriority(0).Apply(contents)
+		for _, c := range got {
+			if c.(TextContent).Text == "untagged" {
+				t.Errorf("Apply() included unannotated content for MinPriority(0): %v", got)
+			}
+		}
+	})
+
+	t.Run("WithTag matches only tagged content", func(t *testing.T) {
+		got := NewContentFilter().WithTag("summary").Apply(contents)
+		if len(got) != 1 || got[0].(TextContent).Text != "tagged" {
+			t.Errorf("Apply() = %v, want only %q", got, "tagged")
+		}
+	})
+
+	t.Run("ForAudience matches only that audience", func(t *testing.T) {
+		got := NewContentFilter().ForAudience(RoleUser).Apply(contents)
+		if len(got) != 1 || got[0].(TextContent).Text != "tagged" {
+			t.Errorf("Apply() = %v, want only %q", got, "tagged")
+		}
+	})
+}
+
+func TestSortByPriority(t *testing.T) {
+	low := withPriority(NewTextContent("low"), 0.2)
+	high := withPriority(NewTextContent("high"), 0.8)
+	none := NewTextContent("none")
+
+	got := SortByPriority([]Content{low, none, high})
+
+	want := []string{"high", "low", "none"}
+	for i, c := range got {
+		if text := c.(TextContent).Text; text != want[i] {
+			t.Errorf("got[%d] = %q, want %q", i, text, want[i])
+		}
+	}
+}
@@ -6,7 +6,11 @@
 
 package mcp
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
 
 const (
 	// ContentTypeText represents text content type
@@ -17,6 +21,12 @@ const (
 	ContentTypeAudio = "audio"
 	// ContentTypeEmbeddedResource represents embedded resource content type
 	ContentTypeEmbeddedResource = "embedded_resource"
+	// ContentTypeImageURI represents image content that is referenced by URI instead of inlined
+	ContentTypeImageURI = "image_uri"
+	// ContentTypeAudioURI represents audio content that is referenced by URI instead of inlined
+	ContentTypeAudioURI = "audio_uri"
+	// ContentTypeVideo represents video content type
+	ContentTypeVideo = "video"
 )
 
 // MCP protcol Layer
@@ -159,10 +169,235 @@ const (
 // Annotated describes an annotated resource.
 type Annotated struct {
 	// Annotations (optional)
-	Annotations *struct {
-		Audience []Role  `json:"audience,omitempty"`
-		Priority float64 `json:"priority,omitempty"`
-	} `json:"annotations,omitempty"`
+	Annotations *Annotations `json:"annotations,omitempty"`
+}
+
+// GetAnnotations returns the Annotations attached to the resource, or nil if none are set. It is
+// promoted onto every type that embeds Annotated, which lets ContentFilter and SortByPriority
+// inspect any Content value's annotations without a type switch over every concrete Content type.
+func (a Annotated) GetAnnotations() *Annotations {
+	return a.Annotations
+}
+
+// Provenance records where a piece of annotated content came from: the tool that produced it,
+// the model (if any) that generated it, when, and the upstream request it was produced for.
+type Provenance struct {
+	Tool              string `json:"tool,omitempty"`
+	Model             string `json:"model,omitempty"`
+	Timestamp         string `json:"timestamp,omitempty"` // RFC 3339
+	UpstreamRequestID string `json:"upstreamRequestId,omitempty"`
+}
+
+// Annotations carries routing and lifecycle metadata about a piece of Content: who it's for and
+// how important it is (Audience, Priority), how long it stays valid (TTL), where it came from
+// (Provenance), how much to trust it (Confidence), and how to categorize it (Tags, Language).
+// Extra preserves any fields unknown to this version of the struct across a JSON round-trip, the
+// same way NotificationParams.AdditionalFields does, so future MCP spec additions don't break
+// existing clients.
+type Annotations struct {
+	Audience []Role  `json:"audience,omitempty"`
+	Priority float64 `json:"priority,omitempty"`
+	// TTL is the number of seconds the content remains valid; clients may drop it afterward.
+	TTL int `json:"ttl,omitempty"`
+	// Provenance records where this content came from.
+	Provenance *Provenance `json:"provenance,omitempty"`
+	// Confidence is a 0-1 score of how much to trust this content.
+	Confidence float64 `json:"confidence,omitempty"`
+	// Tags categorize the content (e.g. "summary", "highlight").
+	Tags []string `json:"tags,omitempty"`
+	// Language is the BCP-47 language tag of the content, e.g. "en-US".
+	Language string `json:"language,omitempty"`
+	// Extra carries fields not recognized by this struct, preserved across unmarshal/marshal.
+	Extra map[string]interface{} `json:"-"`
+}
+
+// knownAnnotationFields lists the JSON keys Annotations decodes explicitly, so UnmarshalJSON
+// knows which keys to leave out of Extra. MarshalJSON does not consult it: it writes Extra first
+// and then the non-empty known fields, which overwrite any Extra entry with the same key.
+var knownAnnotationFields = map[string]bool{
+	"audience":   true,
+	"priority":   true,
+	"ttl":        true,
+	"provenance": true,
+	"confidence": true,
+	"tags":       true,
+	"language":   true,
+}
+
+// MarshalJSON implements custom JSON marshaling for Annotations, flattening Extra into the main
+// JSON object alongside the known fields.
+func (a Annotations) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, len(a.Extra)+7)
+	for k, v := range a.Extra {
+		m[k] = v
+	}
+	if len(a.Audience) > 0 {
+		m["audience"] = a.Audience
+	}
+	if a.Priority != 0 {
+		m["priority"] = a.Priority
+	}
+	if a.TTL != 0 {
+		m["ttl"] = a.TTL
+	}
+	if a.Provenance != nil {
+		m["provenance"] = a.Provenance
+	}
+	if a.Confidence != 0 {
+		m["confidence"] = a.Confidence
+	}
+	if len(a.Tags) > 0 {
+		m["tags"] = a.Tags
+	}
+	if a.Language != "" {
+		m["language"] = a.Language
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling for Annotations, separating known fields
+// from unrecognized ones, which are placed into Extra.
+func (a *Annotations) UnmarshalJSON(data []byte) error {
+	type knownFields Annotations
+	var known knownFields
+	if err := json.Unmarshal(data, &known); err != nil {
+		return err
+	}
+	*a = Annotations(known)
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for k, v := range raw {
+		if knownAnnotationFields[k] {
+			continue
+		}
+		var val interface{}
+		if err := json.Unmarshal(v, &val); err != nil {
+			return err
+		}
+		if a.Extra == nil {
+			a.Extra = make(map[string]interface{})
+		}
+		a.Extra[k] = val
+	}
+	return nil
+}
+
+// ContentFilter builds a predicate over Content values via a fluent API, e.g.
+// NewContentFilter().ForAudience(RoleUser).MinPriority(0.5).WithTag("summary").Apply(contents).
+type ContentFilter struct {
+	audience       Role
+	hasAudience    bool
+	minPriority    float64
+	hasMinPriority bool
+	tag            string
+}
+
+// NewContentFilter creates an empty ContentFilter that matches everything until narrowed.
+func NewContentFilter() *ContentFilter {
+	return &ContentFilter{}
+}
+
+// ForAudience restricts the filter to content whose Annotations.Audience includes role.
+func (f *ContentFilter) ForAudience(role Role) *ContentFilter {
+	f.audience = role
+	f.hasAudience = true
+	return f
+}
+
+// MinPriority restricts the filter to content whose Annotations.Priority is at least priority.
+func (f *ContentFilter) MinPriority(priority float64) *ContentFilter {
+	f.minPriority = priority
+	f.hasMinPriority = true
+	return f
+}
+
+// WithTag restricts the filter to content whose Annotations.Tags includes tag.
+func (f *ContentFilter) WithTag(tag string) *ContentFilter {
+	f.tag = tag
+	return f
+}
+
+// Apply returns the subset of contents that matches every constraint configured on f. Content
+// with no Annotations fails any constraint that was configured.
+func (f *ContentFilter) Apply(contents []Content) []Content {
+	out := make([]Content, 0, len(contents))
+	for _, c := range contents {
+		if f.matches(c) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (f *ContentFilter) matches(c Content) bool {
+	annotations := annotationsOf(c)
+	if f.hasAudience {
+		if annotations == nil || !containsRole(annotations.Audience, f.audience) {
+			return false
+		}
+	}
+	if f.hasMinPriority {
+		if annotations == nil || annotations.Priority < f.minPriority {
+			return false
+		}
+	}
+	if f.tag != "" {
+		if annotations == nil || !containsString(annotations.Tags, f.tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// SortByPriority returns a copy of contents sorted by descending Annotations.Priority. Content
+// with no Annotations sorts as priority 0. Ties preserve the original relative order.
+func SortByPriority(contents []Content) []Content {
+	out := make([]Content, len(contents))
+	copy(out, contents)
+	sort.SliceStable(out, func(i, j int) bool {
+		return priorityOf(out[i]) > priorityOf(out[j])
+	})
+	return out
+}
+
+func priorityOf(c Content) float64 {
+	if annotations := annotationsOf(c); annotations != nil {
+		return annotations.Priority
+	}
+	return 0
+}
+
+// annotatedContent is implemented by every Content variant via its embedded Annotated.
+type annotatedContent interface {
+	GetAnnotations() *Annotations
+}
+
+func annotationsOf(c Content) *Annotations {
+	if a, ok := c.(annotatedContent); ok {
+		return a.GetAnnotations()
+	}
+	return nil
+}
+
+func containsRole(roles []Role, role Role) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
 
 // Content represents different types of message content (text, image, audio, embedded resource).
@@ -181,24 +416,96 @@ func (TextContent) isContent() {}
 
 // ImageContent represents image content
 type ImageContent struct {
-	Type     string `json:"type"`
-	Data     string `json:"data"` // base64 encoded image data
-	MimeType string `json:"mimeType"`
+	Type     string         `json:"type"`
+	Data     string         `json:"data"` // base64 encoded image data
+	MimeType string         `json:"mimeType"`
+	Metadata *ImageMetadata `json:"metadata,omitempty"`
 	Annotated
 }
 
 func (ImageContent) isContent() {}
 
+// ImageMetadata describes the pixel dimensions and color space of an ImageContent payload, so
+// clients can choose an appropriate decoding/rendering path without decoding the image first.
+type ImageMetadata struct {
+	Width      int    `json:"width,omitempty"`
+	Height     int    `json:"height,omitempty"`
+	ColorSpace string `json:"colorSpace,omitempty"` // e.g. "srgb", "display-p3", "cmyk"
+}
+
 // AudioContent represents audio content
 type AudioContent struct {
-	Type     string `json:"type"`
-	Data     string `json:"data"` // base64 encoded audio data
-	MimeType string `json:"mimeType"`
+	Type     string         `json:"type"`
+	Data     string         `json:"data"` // base64 encoded audio data
+	MimeType string         `json:"mimeType"`
+	Encoding *AudioEncoding `json:"encoding,omitempty"`
 	Annotated
 }
 
 func (AudioContent) isContent() {}
 
+// AudioEncoding describes the codec-level details of an AudioContent payload, borrowed from the
+// descriptors media SDKs typically attach to speech/audio output, so clients can pick an
+// appropriate playback path without re-sniffing the payload.
+type AudioEncoding struct {
+	Profile      string `json:"profile,omitempty"`      // e.g. "AAC-LC", "HE-AACv1", "HE-AACv2"
+	Channels     int    `json:"channels,omitempty"`     // number of audio channels
+	SampleRateHz int    `json:"sampleRateHz,omitempty"` // sampling rate in Hz
+	BitrateBps   int    `json:"bitrateBps,omitempty"`   // bitrate in bits per second
+	DurationMs   int    `json:"durationMs,omitempty"`   // duration in milliseconds
+}
+
+// ImageURIContent represents image content referenced by URI rather than inlined as base64.
+// It is preferred over ImageContent for large media where inlining would bloat the JSON-RPC payload.
+type ImageURIContent struct {
+	Type     string `json:"type"`
+	URI      string `json:"uri"`
+	MimeType string `json:"mimeType,omitempty"`
+	Size     int64  `json:"size,omitempty"`     // size of the referenced media in bytes, if known
+	Checksum string `json:"checksum,omitempty"` // content checksum (e.g. "sha256:...") for integrity verification
+	Annotated
+}
+
+func (ImageURIContent) isContent() {}
+
+// AudioURIContent represents audio content referenced by URI rather than inlined as base64.
+// It is preferred over AudioContent for large media where inlining would bloat the JSON-RPC payload.
+type AudioURIContent struct {
+	Type     string `json:"type"`
+	URI      string `json:"uri"`
+	MimeType string `json:"mimeType,omitempty"`
+	Size     int64  `json:"size,omitempty"`     // size of the referenced media in bytes, if known
+	Checksum string `json:"checksum,omitempty"` // content checksum (e.g. "sha256:...") for integrity verification
+	Annotated
+}
+
+func (AudioURIContent) isContent() {}
+
+// VideoContent represents video content, either inlined as base64 Data or referenced by URI.
+// Exactly one of Data or URI should be set.
+type VideoContent struct {
+	Type      string         `json:"type"`
+	Data      string         `json:"data,omitempty"` // base64 encoded video data
+	URI       string         `json:"uri,omitempty"`  // URI reference, for large video that should not be inlined
+	MimeType  string         `json:"mimeType,omitempty"`
+	Duration  int            `json:"duration,omitempty"`  // duration in milliseconds
+	Width     int            `json:"width,omitempty"`     // frame width in pixels
+	Height    int            `json:"height,omitempty"`    // frame height in pixels
+	FrameRate float64        `json:"frameRate,omitempty"` // frames per second
+	Segments  []VideoSegment `json:"segments,omitempty"`  // highlighted/annotated sub-ranges of the video
+	Annotated
+}
+
+func (VideoContent) isContent() {}
+
+// VideoSegment marks an annotated sub-range of a VideoContent, e.g. a highlight or a scene
+// boundary, with its own priority/audience via the embedded Annotated.
+type VideoSegment struct {
+	StartMs int `json:"startMs"`
+	EndMs   int `json:"endMs"`
+	Annotated
+}
+
 // EmbeddedResource represents an embedded resource
 type EmbeddedResource struct {
 	Resource ResourceContents `json:"resource"` // Using generic interface type
@@ -234,6 +541,78 @@ func NewAudioContent(data string, mimeType string) AudioContent {
 	}
 }
 
+// NewAudioContentWithEncoding creates a new audio content with codec metadata attached.
+func NewAudioContentWithEncoding(data string, mimeType string, encoding AudioEncoding) AudioContent {
+	return AudioContent{
+		Type:     ContentTypeAudio,
+		Data:     data,
+		MimeType: mimeType,
+		Encoding: &encoding,
+	}
+}
+
+// NewImageContentWithMetadata creates a new image content with pixel/color-space metadata attached.
+func NewImageContentWithMetadata(data string, mimeType string, metadata ImageMetadata) ImageContent {
+	return ImageContent{
+		Type:     ContentTypeImage,
+		Data:     data,
+		MimeType: mimeType,
+		Metadata: &metadata,
+	}
+}
+
+// ValidateAudio cross-checks the declared MimeType of content against its Encoding fields,
+// e.g. "audio/aac" is expected to carry an AAC profile and "audio/wav" is expected to declare
+// a sample rate. It returns nil if content has no Encoding to check.
+func ValidateAudio(content AudioContent) error {
+	if content.Encoding == nil {
+		return nil
+	}
+	switch content.MimeType {
+	case "audio/aac", "audio/aacp":
+		switch content.Encoding.Profile {
+		case "AAC-LC", "HE-AACv1", "HE-AACv2":
+		default:
+			return fmt.Errorf("mcp: mime type %q requires an AAC profile, got %q", content.MimeType, content.Encoding.Profile)
+		}
+	case "audio/wav", "audio/x-wav":
+		if content.Encoding.SampleRateHz == 0 {
+			return fmt.Errorf("mcp: mime type %q requires a sample rate", content.MimeType)
+		}
+	}
+	return nil
+}
+
+// ValidateImage cross-checks the Metadata attached to content, ensuring that width/height are
+// declared together when either is present. It returns nil if content has no Metadata to check.
+func ValidateImage(content ImageContent) error {
+	if content.Metadata == nil {
+		return nil
+	}
+	if (content.Metadata.Width == 0) != (content.Metadata.Height == 0) {
+		return fmt.Errorf("mcp: image metadata must declare both width and height, or neither")
+	}
+	return nil
+}
+
+// NewVideoContent creates a new video content with inlined base64 data.
+func NewVideoContent(data string, mimeType string) VideoContent {
+	return VideoContent{
+		Type:     ContentTypeVideo,
+		Data:     data,
+		MimeType: mimeType,
+	}
+}
+
+// NewVideoContentFromURI creates a new video content referenced by URI instead of inlined data.
+func NewVideoContentFromURI(uri string, mimeType string) VideoContent {
+	return VideoContent{
+		Type:     ContentTypeVideo,
+		URI:      uri,
+		MimeType: mimeType,
+	}
+}
+
 // NewEmbeddedResource creates a new embedded resource
 func NewEmbeddedResource(resource ResourceContents) EmbeddedResource {
 	return EmbeddedResource{
@@ -241,3 +620,95 @@ func NewEmbeddedResource(resource ResourceContents) EmbeddedResource {
 		Resource: resource,
 	}
 }
+
+// NewImageFromURI creates a new image content referenced by URI instead of inlined base64 data.
+func NewImageFromURI(uri string, mimeType string) ImageURIContent {
+	return ImageURIContent{
+		Type:     ContentTypeImageURI,
+		URI:      uri,
+		MimeType: mimeType,
+	}
+}
+
+// NewAudioFromURI creates a new audio content referenced by URI instead of inlined base64 data.
+func NewAudioFromURI(uri string, mimeType string) AudioURIContent {
+	return AudioURIContent{
+		Type:     ContentTypeAudioURI,
+		URI:      uri,
+		MimeType: mimeType,
+	}
+}
+
+// UnmarshalContent unmarshals raw JSON into the concrete Content variant indicated by its "type"
+// field. It is the dispatch point used wherever a Content value is read off the wire (e.g. tool
+// results), so new content variants must be registered here.
+func UnmarshalContent(data []byte) (Content, error) {
+	var typed struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return nil, err
+	}
+
+	switch typed.Type {
+	case ContentTypeText:
+		var c TextContent
+		err := json.Unmarshal(data, &c)
+		return c, err
+	case ContentTypeImage:
+		var c ImageContent
+		err := json.Unmarshal(data, &c)
+		return c, err
+	case ContentTypeAudio:
+		var c AudioContent
+		err := json.Unmarshal(data, &c)
+		return c, err
+	case ContentTypeImageURI:
+		var c ImageURIContent
+		err := json.Unmarshal(data, &c)
+		return c, err
+	case ContentTypeAudioURI:
+		var c AudioURIContent
+		err := json.Unmarshal(data, &c)
+		return c, err
+	case ContentTypeVideo:
+		var c VideoContent
+		err := json.Unmarshal(data, &c)
+		return c, err
+	case ContentTypeEmbeddedResource:
+		return unmarshalEmbeddedResource(data)
+	default:
+		return nil, fmt.Errorf("unknown content type: %q", typed.Type)
+	}
+}
+
+// unmarshalEmbeddedResource decodes an embedded_resource content value. Resource is a
+// ResourceContents interface, so plain json.Unmarshal into EmbeddedResource cannot populate it;
+// the resource field is decoded separately and handed to parseResourceContents, which picks the
+// concrete ResourceContents implementation by shape, the same way it's used to decode resource
+// read results elsewhere in this codebase.
+func unmarshalEmbeddedResource(data []byte) (Content, error) {
+	var wrapper struct {
+		Type     string          `json:"type"`
+		Resource json.RawMessage `json:"resource"`
+		Annotated
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return nil, err
+	}
+
+	var resourceMap map[string]interface{}
+	if err := json.Unmarshal(wrapper.Resource, &resourceMap); err != nil {
+		return nil, err
+	}
+	resource, err := parseResourceContents(resourceMap)
+	if err != nil {
+		return nil, fmt.Errorf("mcp: parsing embedded resource: %w", err)
+	}
+
+	return EmbeddedResource{
+		Type:      wrapper.Type,
+		Resource:  resource,
+		Annotated: wrapper.Annotated,
+	}, nil
+}
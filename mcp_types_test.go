@@ -0,0 +1,72 @@
+// Tencent is pleased to support the open source community by making trpc-mcp-go available.
+//
+// Copyright (C) 2025 THL A29 Limited, a Tencent company.  All rights reserved.
+//
+// trpc-mcp-go is licensed under the Apache License Version 2.0.
+
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnmarshalContentRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		content Content
+	}{
+		{"text", NewTextContent("hello")},
+		{"image", NewImageContent("YWJj", "image/png")},
+		{"audio", NewAudioContent("YWJj", "audio/wav")},
+		{"image_with_metadata", NewImageContentWithMetadata("YWJj", "image/png", ImageMetadata{
+			Width:      640,
+			Height:     480,
+			ColorSpace: "srgb",
+		})},
+		{"audio_with_encoding", NewAudioContentWithEncoding("YWJj", "audio/aac", AudioEncoding{
+			Profile:      "AAC-LC",
+			Channels:     2,
+			SampleRateHz: 44100,
+			BitrateBps:   128000,
+			DurationMs:   1500,
+		})},
+		{"image_uri", NewImageFromURI("https://example.com/a.png", "image/png")},
+		{"audio_uri", NewAudioFromURI("https://example.com/a.wav", "audio/wav")},
+		{"video", NewVideoContent("YWJj", "video/mp4")},
+		{"embedded_resource", NewEmbeddedResource(TextResourceContents{
+			URI:      "file:///tmp/a.txt",
+			MimeType: "text/plain",
+			Text:     "hello",
+		})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.content)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			got, err := UnmarshalContent(data)
+			if err != nil {
+				t.Fatalf("UnmarshalContent() error = %v", err)
+			}
+
+			roundTripped, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("Marshal() of round-tripped content error = %v", err)
+			}
+			if string(roundTripped) != string(data) {
+				t.Errorf("round trip mismatch:\n got: %s\nwant: %s", roundTripped, data)
+			}
+		})
+	}
+}
+
+func TestUnmarshalContentUnknownType(t *testing.T) {
+	_, err := UnmarshalContent([]byte(`{"type":"bogus"}`))
+	if err == nil {
+		t.Fatal("expected error for unknown content type, got nil")
+	}
+}
@@ -0,0 +1,102 @@
+// Tencent is pleased to support the open source community by making trpc-mcp-go available.
+//
+// Copyright (C) 2025 THL A29 Limited, a Tencent company.  All rights reserved.
+//
+// trpc-mcp-go is licensed under the Apache License Version 2.0.
+
+package mcp
+
+import "testing"
+
+func TestValidateAudio(t *testing.T) {
+	tests := []struct {
+		name    string
+		content AudioContent
+		wantErr bool
+	}{
+		{
+			name:    "no encoding is always valid",
+			content: NewAudioContent("", "audio/aac"),
+		},
+		{
+			name:    "aac with a valid profile",
+			content: NewAudioContentWithEncoding("", "audio/aac", AudioEncoding{Profile: "AAC-LC"}),
+		},
+		{
+			name:    "aacp with a valid HE-AAC profile",
+			content: NewAudioContentWithEncoding("", "audio/aacp", AudioEncoding{Profile: "HE-AACv2"}),
+		},
+		{
+			name:    "aac with no profile",
+			content: NewAudioContentWithEncoding("", "audio/aac", AudioEncoding{}),
+			wantErr: true,
+		},
+		{
+			name:    "aac with an unrecognized profile",
+			content: NewAudioContentWithEncoding("", "audio/aac", AudioEncoding{Profile: "mp3"}),
+			wantErr: true,
+		},
+		{
+			name:    "wav with a sample rate",
+			content: NewAudioContentWithEncoding("", "audio/wav", AudioEncoding{SampleRateHz: 44100}),
+		},
+		{
+			name:    "wav with no sample rate",
+			content: NewAudioContentWithEncoding("", "audio/wav", AudioEncoding{}),
+			wantErr: true,
+		},
+		{
+			name:    "unrelated mime type with an encoding is not cross-checked",
+			content: NewAudioContentWithEncoding("", "audio/ogg", AudioEncoding{}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAudio(tt.content)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAudio() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateImage(t *testing.T) {
+	tests := []struct {
+		name    string
+		content ImageContent
+		wantErr bool
+	}{
+		{
+			name:    "no metadata is always valid",
+			content: NewImageContent("", "image/png"),
+		},
+		{
+			name:    "width and height both set",
+			content: NewImageContentWithMetadata("", "image/png", ImageMetadata{Width: 640, Height: 480}),
+		},
+		{
+			name:    "neither width nor height set",
+			content: NewImageContentWithMetadata("", "image/png", ImageMetadata{ColorSpace: "srgb"}),
+		},
+		{
+			name:    "width without height",
+			content: NewImageContentWithMetadata("", "image/png", ImageMetadata{Width: 640}),
+			wantErr: true,
+		},
+		{
+			name:    "height without width",
+			content: NewImageContentWithMetadata("", "image/png", ImageMetadata{Height: 480}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateImage(tt.content)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateImage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// Tencent is pleased to support the open source community by making trpc-mcp-go available.
+//
+// Copyright (C) 2025 THL A29 Limited, a Tencent company.  All rights reserved.
+//
+// trpc-mcp-go is licensed under the Apache License Version 2.0.
+
+package transform
+
+import (
+	"context"
+	"fmt"
+
+	mcp "trpc.group/trpc-go/trpc-mcp-go"
+)
+
+// NoopProvider is a ContentTransformer that returns an error naming the provider it stands in
+// for instead of performing any conversion. It exists so callers can wire up the
+// transform pipeline (registry, pipeline hooks) before a real speech/vision backend is
+// available, and as a template for implementing real providers such as Whisper, Google Speech,
+// or Azure OpenAI's audio speech API.
+type NoopProvider struct {
+	// Name is the provider name this stub stands in for, used only in its error message.
+	Name string
+}
+
+// Transform implements ContentTransformer by always failing with a descriptive error.
+func (p NoopProvider) Transform(ctx context.Context, in mcp.Content, opts TransformOptions) (mcp.Content, error) {
+	return nil, fmt.Errorf("transform: provider %q is not implemented (stub)", p.Name)
+}
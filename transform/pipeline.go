@@ -0,0 +1,107 @@
+// Tencent is pleased to support the open source community by making trpc-mcp-go available.
+//
+// Copyright (C) 2025 THL A29 Limited, a Tencent company.  All rights reserved.
+//
+// trpc-mcp-go is licensed under the Apache License Version 2.0.
+
+package transform
+
+import (
+	"context"
+
+	mcp "trpc.group/trpc-go/trpc-mcp-go"
+)
+
+// Option configures a Pipeline.
+type Option func(*Pipeline)
+
+// WithAutoTranscribe makes the Pipeline run every AudioContent in a tool result through provider
+// (an audio->text transformer registered on the Pipeline's Registry) and attach the resulting
+// TextContent alongside it, so a tool can return AudioContent and still be usable from a
+// text-only client without implementing its own speech-to-text.
+func WithAutoTranscribe(provider string) Option {
+	return func(p *Pipeline) {
+		p.autoTranscribeProvider = provider
+	}
+}
+
+// Pipeline post-processes a tool's result content list before it's sent to the client, applying
+// whichever transforms were configured via Option values such as WithAutoTranscribe.
+type Pipeline struct {
+	registry               *Registry
+	autoTranscribeProvider string
+}
+
+// NewPipeline creates a Pipeline backed by registry, configured by opts.
+func NewPipeline(registry *Registry, opts ...Option) *Pipeline {
+	p := &Pipeline{registry: registry}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Process runs results through every transform the Pipeline was configured with, in the order
+// they were configured, and returns the resulting content list. A tool-result handler should call
+// this on every result before returning it to the client.
+func (p *Pipeline) Process(ctx context.Context, results []mcp.Content) ([]mcp.Content, error) {
+	out := results
+	if p.autoTranscribeProvider != "" {
+		transcribed, err := AttachTranscriptions(ctx, p.registry, p.autoTranscribeProvider, out)
+		if err != nil {
+			return nil, err
+		}
+		out = transcribed
+	}
+	return out, nil
+}
+
+// transcriptTag marks a TextContent produced by AttachTranscriptions, so ContentFilter can single
+// out transcripts (e.g. NewContentFilter().WithTag(transcriptTag)) the same way it can single out
+// any other derived content.
+const transcriptTag = "transcript"
+
+// AttachTranscriptions walks a tool result's content list and, for every AudioContent found,
+// runs it through the registry's audio->text transformer for provider and appends the resulting
+// TextContent right after it. The original AudioContent is left untouched so voice-capable
+// clients still see it unchanged. The transcript inherits the original's Annotations.Audience (so
+// it still reaches whoever the audio was addressed to) and is additionally tagged "transcript", so
+// a client can filter "audio for voice clients" vs "the transcript for text clients" via
+// ContentFilter.ForAudience/WithTag instead of having to distinguish them by content type alone.
+// Pipeline.Process is the entry point that wires this into a server's tool-result handling via
+// WithAutoTranscribe; call this directly only if you need finer control than Pipeline gives you.
+func AttachTranscriptions(ctx context.Context, registry *Registry, provider string, results []mcp.Content) ([]mcp.Content, error) {
+	out := make([]mcp.Content, 0, len(results))
+	for _, c := range results {
+		out = append(out, c)
+		audio, ok := c.(mcp.AudioContent)
+		if !ok {
+			continue
+		}
+		transcribed, err := registry.Transform(ctx, mcp.ContentTypeAudio, mcp.ContentTypeText, provider, audio)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, tagTranscript(transcribed, audio.Annotations))
+	}
+	return out, nil
+}
+
+// tagTranscript returns a copy of transcribed with its Annotations set to carry originalAnnotations'
+// Audience (if any) plus the transcriptTag, so the transcript can be routed the same way the audio
+// it came from was, while still being distinguishable from it.
+func tagTranscript(transcribed mcp.Content, originalAnnotations *mcp.Annotations) mcp.Content {
+	text, ok := transcribed.(mcp.TextContent)
+	if !ok {
+		return transcribed
+	}
+	var audience []mcp.Role
+	if originalAnnotations != nil {
+		audience = originalAnnotations.Audience
+	}
+	text.Annotations = &mcp.Annotations{
+		Audience: audience,
+		Tags:     []string{transcriptTag},
+	}
+	return text
+}
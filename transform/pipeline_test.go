@@ -0,0 +1,143 @@
+// Tencent is pleased to support the open source community by making trpc-mcp-go available.
+//
+// Copyright (C) 2025 THL A29 Limited, a Tencent company.  All rights reserved.
+//
+// trpc-mcp-go is licensed under the Apache License Version 2.0.
+
+package transform
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mcp "trpc.group/trpc-go/trpc-mcp-go"
+)
+
+type stubTranscriber struct {
+	text string
+	err  error
+}
+
+func (s stubTranscriber) Transform(ctx context.Context, in mcp.Content, opts TransformOptions) (mcp.Content, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return mcp.NewTextContent(s.text), nil
+}
+
+func TestRegistryRegisterLookupTransform(t *testing.T) {
+	registry := NewRegistry()
+
+	if _, ok := registry.Lookup(mcp.ContentTypeAudio, mcp.ContentTypeText, "whisper-local"); ok {
+		t.Fatal("Lookup() found a transformer before one was registered")
+	}
+
+	registry.Register(mcp.ContentTypeAudio, mcp.ContentTypeText, "whisper-local", stubTranscriber{text: "hello"})
+
+	if _, ok := registry.Lookup(mcp.ContentTypeAudio, mcp.ContentTypeText, "whisper-local"); !ok {
+		t.Fatal("Lookup() did not find the registered transformer")
+	}
+
+	out, err := registry.Transform(context.Background(), mcp.ContentTypeAudio, mcp.ContentTypeText, "whisper-local", mcp.NewAudioContent("", "audio/wav"))
+	if err != nil {
+		t.Fatalf("Transform() error = %v", err)
+	}
+	if text, ok := out.(mcp.TextContent); !ok || text.Text != "hello" {
+		t.Errorf("Transform() = %v, want TextContent{Text: hello}", out)
+	}
+}
+
+func TestRegistryTransformUnregistered(t *testing.T) {
+	registry := NewRegistry()
+	if _, err := registry.Transform(context.Background(), mcp.ContentTypeAudio, mcp.ContentTypeText, "whisper-local", mcp.NewAudioContent("", "audio/wav")); err == nil {
+		t.Fatal("Transform() expected an error for an unregistered provider, got nil")
+	}
+}
+
+func TestNoopProvider(t *testing.T) {
+	provider := NoopProvider{Name: "whisper-local"}
+	if _, err := provider.Transform(context.Background(), mcp.NewAudioContent("", "audio/wav"), TransformOptions{}); err == nil {
+		t.Fatal("NoopProvider.Transform() expected an error, got nil")
+	}
+}
+
+func TestAttachTranscriptions(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(mcp.ContentTypeAudio, mcp.ContentTypeText, "whisper-local", stubTranscriber{text: "transcript"})
+
+	audio := mcp.NewAudioContent("YWJj", "audio/wav")
+	text := mcp.NewTextContent("already text")
+
+	out, err := AttachTranscriptions(context.Background(), registry, "whisper-local", []mcp.Content{audio, text})
+	if err != nil {
+		t.Fatalf("AttachTranscriptions() error = %v", err)
+	}
+
+	if len(out) != 3 {
+		t.Fatalf("AttachTranscriptions() returned %d items, want 3", len(out))
+	}
+	if out[0] != mcp.Content(audio) {
+		t.Errorf("out[0] = %v, want the original audio unchanged", out[0])
+	}
+	transcript, ok := out[1].(mcp.TextContent)
+	if !ok || transcript.Text != "transcript" {
+		t.Fatalf("out[1] = %v, want the transcript TextContent", out[1])
+	}
+	if transcript.Annotations == nil || len(transcript.Annotations.Tags) != 1 || transcript.Annotations.Tags[0] != transcriptTag {
+		t.Errorf("transcript.Annotations = %v, want Tags = [%q]", transcript.Annotations, transcriptTag)
+	}
+	if out[2] != mcp.Content(text) {
+		t.Errorf("out[2] = %v, want the pre-existing text content untouched", out[2])
+	}
+}
+
+func TestAttachTranscriptionsUnregisteredProvider(t *testing.T) {
+	registry := NewRegistry()
+	audio := mcp.NewAudioContent("YWJj", "audio/wav")
+
+	if _, err := AttachTranscriptions(context.Background(), registry, "whisper-local", []mcp.Content{audio}); err == nil {
+		t.Fatal("AttachTranscriptions() expected an error for an unregistered provider, got nil")
+	}
+}
+
+func TestPipelineProcess(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(mcp.ContentTypeAudio, mcp.ContentTypeText, "whisper-local", stubTranscriber{text: "transcript"})
+	pipeline := NewPipeline(registry, WithAutoTranscribe("whisper-local"))
+
+	audio := mcp.NewAudioContent("YWJj", "audio/wav")
+	out, err := pipeline.Process(context.Background(), []mcp.Content{audio})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if len(out) != 2 {
+		t.Fatalf("Process() returned %d items, want 2", len(out))
+	}
+	if _, ok := out[1].(mcp.TextContent); !ok {
+		t.Errorf("Process() out[1] = %T, want mcp.TextContent", out[1])
+	}
+}
+
+func TestPipelineProcessSurfacesTransformError(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(mcp.ContentTypeAudio, mcp.ContentTypeText, "whisper-local", stubTranscriber{err: errors.New("boom")})
+	pipeline := NewPipeline(registry, WithAutoTranscribe("whisper-local"))
+
+	if _, err := pipeline.Process(context.Background(), []mcp.Content{mcp.NewAudioContent("YWJj", "audio/wav")}); err == nil {
+		t.Fatal("Process() expected an error from a failing transformer, got nil")
+	}
+}
+
+func TestPipelineProcessNoTranscribeOptionPassesThrough(t *testing.T) {
+	pipeline := NewPipeline(NewRegistry())
+
+	text := mcp.NewTextContent("hello")
+	out, err := pipeline.Process(context.Background(), []mcp.Content{text})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if len(out) != 1 || out[0] != mcp.Content(text) {
+		t.Errorf("Process() = %v, want content passed through unchanged", out)
+	}
+}
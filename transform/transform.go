@@ -0,0 +1,81 @@
+// Tencent is pleased to support the open source community by making trpc-mcp-go available.
+//
+// Copyright (C) 2025 THL A29 Limited, a Tencent company.  All rights reserved.
+//
+// trpc-mcp-go is licensed under the Apache License Version 2.0.
+
+// Package transform lets a server or middleware convert Content values between types via
+// registered providers, e.g. speech-to-text, text-to-speech, or OCR/captioning. A tool can
+// return one Content type and have the pipeline attach another, so MCP servers are usable from
+// both voice and text clients without each tool implementing its own conversion.
+package transform
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	mcp "trpc.group/trpc-go/trpc-mcp-go"
+)
+
+// TransformOptions carries provider-specific knobs for a single Transform call.
+type TransformOptions struct {
+	// Provider is the name the transformer was registered under (see Registry.Register).
+	Provider string
+	// Extra carries provider-specific parameters not covered by the common fields above.
+	Extra map[string]interface{}
+}
+
+// ContentTransformer converts a Content value of one type into a Content value of another,
+// e.g. AudioContent -> TextContent for speech-to-text.
+type ContentTransformer interface {
+	// Transform converts in into a new Content value according to opts.
+	Transform(ctx context.Context, in mcp.Content, opts TransformOptions) (mcp.Content, error)
+}
+
+// key identifies a registered transformer by the content types it converts between and the
+// name of the provider implementing it.
+type key struct {
+	fromType string
+	toType   string
+	provider string
+}
+
+// Registry holds ContentTransformer implementations keyed by (fromType, toType, providerName).
+type Registry struct {
+	mu           sync.RWMutex
+	transformers map[key]ContentTransformer
+}
+
+// NewRegistry creates an empty transformer Registry.
+func NewRegistry() *Registry {
+	return &Registry{transformers: make(map[key]ContentTransformer)}
+}
+
+// Register registers transformer under (fromType, toType, provider). Registering the same key
+// twice replaces the previous transformer.
+func (r *Registry) Register(fromType, toType, provider string, transformer ContentTransformer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.transformers[key{fromType, toType, provider}] = transformer
+}
+
+// Lookup returns the transformer registered for (fromType, toType, provider), or false if none
+// is registered.
+func (r *Registry) Lookup(fromType, toType, provider string) (ContentTransformer, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.transformers[key{fromType, toType, provider}]
+	return t, ok
+}
+
+// Transform looks up the transformer registered for (fromType, toType, provider) and invokes it
+// on in. fromType and toType are used as given by the caller; in's concrete type is not checked
+// against fromType.
+func (r *Registry) Transform(ctx context.Context, fromType, toType, provider string, in mcp.Content) (mcp.Content, error) {
+	t, ok := r.Lookup(fromType, toType, provider)
+	if !ok {
+		return nil, fmt.Errorf("transform: no transformer registered for %s->%s via %q", fromType, toType, provider)
+	}
+	return t.Transform(ctx, in, TransformOptions{Provider: provider})
+}